@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/hashicorp/vault/api"
+)
+
+// vc is the shared Vault API client used by every command.
+var vc *api.Client
+
+// InitializeClient builds the shared Vault client from the given
+// configuration and authenticates it with the configured token.
+func InitializeClient(cfg *Config) error {
+	clientConfig := api.DefaultConfig()
+	clientConfig.Address = cfg.Address
+
+	client, err := api.NewClient(clientConfig)
+	if err != nil {
+		return err
+	}
+
+	client.SetToken(cfg.Token)
+	vc = client
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/cli"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// BaseCommand holds the flags and output helpers shared by every command
+// that renders a secret: -field to extract a single value and -format to
+// choose how the full secret is rendered.
+type BaseCommand struct {
+	Ui cli.Ui
+
+	flagField  string
+	flagFormat string
+}
+
+// FlagSet returns a flag.FlagSet pre-populated with the shared -field and
+// -format flags. Commands should parse their own flags against the
+// returned set so both sets of flags are available together.
+func (c *BaseCommand) FlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.StringVar(&c.flagField, "field", "", "print only this field of the secret")
+	flags.StringVar(&c.flagFormat, "format", "table", "output format: json, yaml or table")
+	return flags
+}
+
+// outputSecret renders data according to the parsed -field/-format flags.
+// It returns the command exit code: 0 on success, 1 if -field was given
+// but the field is missing.
+func (c *BaseCommand) outputSecret(data map[string]interface{}) int {
+	if c.flagField != "" {
+		value, ok := data[c.flagField]
+		if !ok {
+			c.Ui.Error(fmt.Sprintf("field %q not present in secret", c.flagField))
+			return 1
+		}
+		c.Ui.Output(fmt.Sprintf("%v", value))
+		return 0
+	}
+
+	switch c.flagFormat {
+	case "json":
+		b, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error formatting secret as json: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(b))
+	case "yaml":
+		b, err := yaml.Marshal(data)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error formatting secret as yaml: %s", err))
+			return 1
+		}
+		c.Ui.Output(strings.TrimRight(string(b), "\n"))
+	default:
+		keys := make([]string, 0, len(data))
+		for k := range data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			c.Ui.Output(fmt.Sprintf("%s\t%v", k, data[k]))
+		}
+	}
+
+	return 0
+}
+
+// outputKeys renders a list of keys according to the parsed -format flag.
+// Unlike outputSecret, it preserves the keys' natural order (the order
+// Vault returned them in) rather than a map's, and renders -format=json
+// and -format=yaml as an array instead of an object keyed by index.
+func (c *BaseCommand) outputKeys(keys []string) int {
+	if c.flagField != "" {
+		c.Ui.Error("-field is not supported by this command")
+		return 1
+	}
+
+	switch c.flagFormat {
+	case "json":
+		b, err := json.MarshalIndent(keys, "", "  ")
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error formatting keys as json: %s", err))
+			return 1
+		}
+		c.Ui.Output(string(b))
+	case "yaml":
+		b, err := yaml.Marshal(keys)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error formatting keys as yaml: %s", err))
+			return 1
+		}
+		c.Ui.Output(strings.TrimRight(string(b), "\n"))
+	default:
+		for _, k := range keys {
+			c.Ui.Output(k)
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestReadFieldAndFormat(t *testing.T) {
+
+	err := LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	err = InitializeClient(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	data := make(map[string]interface{})
+	data["password"] = "hunter2"
+
+	if _, err := vc.Logical().Write("secret/read-flags", data); err != nil {
+		t.Fatalf("Unable to write test secret: %q", err)
+	}
+
+	t.Run("Field", func(t *testing.T) {
+
+		ui := new(cli.MockUi)
+		c := &ReadCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"-field=password", "secret/read-flags"}
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := "hunter2"
+		if actual := ui.OutputWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+
+	t.Run("MissingField", func(t *testing.T) {
+
+		ui := new(cli.MockUi)
+		c := &ReadCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"-field=doesntexist", "secret/read-flags"}
+		if rc := c.Run(args); rc != 1 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := "field \"doesntexist\" not present in secret"
+		if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+
+	t.Run("FormatJSON", func(t *testing.T) {
+
+		ui := new(cli.MockUi)
+		c := &ReadCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"-format=json", "secret/read-flags"}
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := `"password": "hunter2"`
+		if actual := ui.OutputWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// Config holds the settings needed to talk to a Vault server. Values are
+// sourced from the environment, mirroring the conventions of the official
+// Vault CLI (VAULT_ADDR, VAULT_TOKEN).
+type Config struct {
+	Address string
+	Token   string
+}
+
+// cfg is the process-wide configuration populated by LoadConfig.
+var cfg *Config
+
+// LoadConfig reads the Vault connection settings from the environment and
+// stores them in the package-level cfg variable.
+func LoadConfig() error {
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		addr = "https://127.0.0.1:8200"
+	}
+
+	token := os.Getenv("VAULT_TOKEN")
+	if token == "" {
+		return fmt.Errorf("VAULT_TOKEN must be set")
+	}
+
+	cfg = &Config{
+		Address: addr,
+		Token:   token,
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// DeleteCommand removes a secret at a given path. On KV v2 mounts this is
+// a soft-delete of the latest version (or a set of versions via
+// -versions) unless -metadata is given, in which case the secret and all
+// of its version history is permanently removed.
+type DeleteCommand struct {
+	Ui cli.Ui
+}
+
+func (c *DeleteCommand) Run(args []string) int {
+	flags := flag.NewFlagSet("rm", flag.ContinueOnError)
+	versionsRaw := flags.String("versions", "", "comma separated list of versions to delete (KV v2 only)")
+	metadata := flags.Bool("metadata", false, "permanently delete the secret and all of its versions (KV v2 only)")
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) > 1 {
+		c.Ui.Error("The rm command expects at most one argument")
+		return 1
+	}
+	if len(args) == 0 {
+		c.Ui.Error("The rm command expects at least one argument")
+		return 1
+	}
+
+	path := args[0]
+
+	mount, err := kvMountFor(path)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	readPath := path
+	if mount.v2 {
+		readPath = mount.dataPath("data", path)
+	}
+
+	secret, err := vc.Logical().Read(readPath)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading secret: %s", err))
+		return 1
+	}
+	if secret == nil {
+		c.Ui.Error("Secret does not exist")
+		return 1
+	}
+
+	if !mount.v2 {
+		if _, err := vc.Logical().Delete(path); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error deleting secret: %s", err))
+			return 1
+		}
+		return 0
+	}
+
+	switch {
+	case *metadata:
+		if _, err := vc.Logical().Delete(mount.dataPath("metadata", path)); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error deleting secret metadata: %s", err))
+			return 1
+		}
+	case *versionsRaw != "":
+		versions, err := parseVersions(*versionsRaw)
+		if err != nil {
+			c.Ui.Error(err.Error())
+			return 1
+		}
+		if _, err := vc.Logical().Write(mount.dataPath("delete", path), map[string]interface{}{"versions": versions}); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error deleting secret versions: %s", err))
+			return 1
+		}
+	default:
+		if _, err := vc.Logical().Delete(mount.dataPath("data", path)); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error deleting secret: %s", err))
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func (c *DeleteCommand) Help() string {
+	helpText := `
+Usage: vault-client rm [options] [path]
+
+  Deletes the secret at the given path. On KV v2 mounts this soft-deletes
+  the latest version; pass -versions to target specific versions, or
+  -metadata to permanently remove the secret and all of its history.
+
+Options:
+
+  -versions=1,2,3  Only delete the given versions (KV v2 only)
+  -metadata        Permanently delete the secret and all versions (KV v2 only)
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *DeleteCommand) Synopsis() string {
+	return "Delete a secret"
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/vault/api"
 	"github.com/mitchellh/cli"
 )
 
@@ -81,4 +82,64 @@ func TestDelete(t *testing.T) {
 		}
 
 	})
-}
\ No newline at end of file
+
+	t.Run("KVv2", func(t *testing.T) {
+
+		err := vc.Sys().Mount("kv-v2", &api.MountInput{
+			Type:    "kv",
+			Options: map[string]string{"version": "2"},
+		})
+		if err != nil {
+			t.Fatalf("Unable to mount kv-v2 engine: %q", err)
+		}
+
+		data := make(map[string]interface{})
+		data["data"] = map[string]interface{}{"key": "v1"}
+
+		if _, err := vc.Logical().Write("kv-v2/data/existent", data); err != nil {
+			t.Fatalf("Unable to write version 1: %q", err)
+		}
+
+		data["data"] = map[string]interface{}{"key": "v2"}
+		if _, err := vc.Logical().Write("kv-v2/data/existent", data); err != nil {
+			t.Fatalf("Unable to write version 2: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &DeleteCommand{Ui: ui}
+
+		args := []string{"-versions=2", "kv-v2/existent"}
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		meta, err := vc.Logical().Read("kv-v2/metadata/existent")
+		if err != nil {
+			t.Fatalf("Unable to read metadata: %q", err)
+		}
+
+		versions, ok := meta.Data["versions"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("expected versions metadata, got: %v", meta.Data["versions"])
+		}
+
+		v2, ok := versions["2"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("expected metadata for version 2, got: %v", versions["2"])
+		}
+
+		if v2["deletion_time"] == "" {
+			t.Fatalf("expected deletion_time to be set for version 2")
+		}
+
+		undelete := &UndeleteCommand{Ui: new(cli.MockUi)}
+		if rc := undelete.Run([]string{"-versions=2", "kv-v2/existent"}); rc != 0 {
+			t.Fatalf("undelete failed")
+		}
+
+		destroy := &DestroyCommand{Ui: new(cli.MockUi)}
+		if rc := destroy.Run([]string{"-versions=2", "kv-v2/existent"}); rc != 0 {
+			t.Fatalf("destroy failed")
+		}
+	})
+}
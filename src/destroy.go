@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// DestroyCommand permanently removes the underlying data of one or more
+// versions of a KV v2 secret. Unlike DeleteCommand, this cannot be undone
+// with UndeleteCommand.
+type DestroyCommand struct {
+	Ui cli.Ui
+}
+
+func (c *DestroyCommand) Run(args []string) int {
+	flags := flag.NewFlagSet("destroy", flag.ContinueOnError)
+	versionsRaw := flags.String("versions", "", "comma separated list of versions to destroy")
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) != 1 {
+		c.Ui.Error("The destroy command expects exactly one argument")
+		return 1
+	}
+	if *versionsRaw == "" {
+		c.Ui.Error("The destroy command requires -versions")
+		return 1
+	}
+
+	path := args[0]
+
+	mount, err := kvMountFor(path)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	if !mount.v2 {
+		c.Ui.Error("destroy is only supported for KV v2 mounts")
+		return 1
+	}
+
+	versions, err := parseVersions(*versionsRaw)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	_, err = vc.Logical().Write(mount.dataPath("destroy", path), map[string]interface{}{"versions": versions})
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error destroying secret versions: %s", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (c *DestroyCommand) Help() string {
+	helpText := `
+Usage: vault-client destroy -versions=1,2,3 [path]
+
+  Permanently destroys the given versions of a KV v2 secret. This cannot
+  be undone.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *DestroyCommand) Synopsis() string {
+	return "Permanently destroy secret versions"
+}
@@ -0,0 +1,106 @@
+package main
+
+// readSecret reads the secret at path, transparently handling KV v2
+// mounts, and returns nil data if the secret does not exist.
+func readSecret(path string) (map[string]interface{}, error) {
+	mount, err := kvMountFor(path)
+	if err != nil {
+		return nil, err
+	}
+
+	readPath := path
+	if mount.v2 {
+		readPath = mount.dataPath("data", path)
+	}
+
+	secret, err := vc.Logical().Read(readPath)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, nil
+	}
+
+	if mount.v2 {
+		data, _ := secret.Data["data"].(map[string]interface{})
+		return data, nil
+	}
+
+	return secret.Data, nil
+}
+
+// writeSecret writes data to path, transparently handling KV v2 mounts,
+// and returns whatever response data Vault sent back (e.g. KV v2 version
+// metadata), which may be nil.
+func writeSecret(path string, data map[string]interface{}) (map[string]interface{}, error) {
+	mount, err := kvMountFor(path)
+	if err != nil {
+		return nil, err
+	}
+
+	writePath := path
+	if mount.v2 {
+		writePath = mount.dataPath("data", path)
+		data = map[string]interface{}{"data": data}
+	}
+
+	secret, err := vc.Logical().Write(writePath, data)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, nil
+	}
+
+	return secret.Data, nil
+}
+
+// deleteSecret deletes the secret at path, transparently handling KV v2
+// mounts by removing all of its version history.
+func deleteSecret(path string) error {
+	mount, err := kvMountFor(path)
+	if err != nil {
+		return err
+	}
+
+	deletePath := path
+	if mount.v2 {
+		deletePath = mount.dataPath("metadata", path)
+	}
+
+	_, err = vc.Logical().Delete(deletePath)
+	return err
+}
+
+// listSecrets lists the keys found directly under path, transparently
+// handling KV v2 mounts. Keys that are themselves prefixes are returned
+// with a trailing slash, matching vc.Logical().List's convention.
+func listSecrets(path string) ([]string, error) {
+	mount, err := kvMountFor(path)
+	if err != nil {
+		return nil, err
+	}
+
+	listPath := path
+	if mount.v2 {
+		listPath = mount.dataPath("metadata", path)
+	}
+
+	secret, err := vc.Logical().List(listPath)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, nil
+	}
+
+	raw, _ := secret.Data["keys"].([]interface{})
+	keys := make([]string, 0, len(raw))
+	for _, k := range raw {
+		if s, ok := k.(string); ok {
+			keys = append(keys, s)
+		}
+	}
+
+	return keys, nil
+}
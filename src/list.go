@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ListCommand lists the secrets found directly under a given path.
+type ListCommand struct {
+	BaseCommand
+}
+
+func (c *ListCommand) Run(args []string) int {
+	flags := c.FlagSet("list")
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) != 1 {
+		c.Ui.Error("The list command expects exactly one argument")
+		return 1
+	}
+
+	path := args[0]
+
+	keys, err := listSecrets(path)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing secrets: %s", err))
+		return 1
+	}
+	if keys == nil {
+		c.Ui.Error("Secret does not exist")
+		return 1
+	}
+
+	return c.outputKeys(keys)
+}
+
+func (c *ListCommand) Help() string {
+	helpText := `
+Usage: vault-client list [options] [path]
+
+  Lists the secrets found directly under the given path.
+
+Options:
+
+  -format=table      Output format: json, yaml or table (default: table)
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ListCommand) Synopsis() string {
+	return "List secrets under a path"
+}
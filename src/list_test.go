@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestList(t *testing.T) {
+
+	err := LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	err = InitializeClient(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	ui := new(cli.MockUi)
+	c := &ListCommand{BaseCommand{Ui: ui}}
+
+	t.Run("TooFewArgs", func(t *testing.T) {
+
+		if rc := c.Run(nil); rc != 1 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := "The list command expects exactly one argument"
+		if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+
+	t.Run("NonexistentPrefix", func(t *testing.T) {
+
+		ui := new(cli.MockUi)
+		c := &ListCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"secret/list-doesntexist"}
+
+		if rc := c.Run(args); rc != 1 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := "Secret does not exist"
+		if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+
+	t.Run("ExistentPrefix", func(t *testing.T) {
+
+		data := map[string]interface{}{"key": "value"}
+		if _, err := vc.Logical().Write("secret/list-test/a", data); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+		if _, err := vc.Logical().Write("secret/list-test/b", data); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &ListCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"secret/list-test"}
+
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		actual := ui.OutputWriter.String()
+		for _, key := range []string{"a", "b"} {
+			if !strings.Contains(actual, key) {
+				t.Fatalf("expected:\n%s\n\nto include: %q", actual, key)
+			}
+		}
+	})
+
+	t.Run("FormatJSONRendersArray", func(t *testing.T) {
+
+		data := map[string]interface{}{"key": "value"}
+		for i := 0; i < 11; i++ {
+			path := fmt.Sprintf("secret/list-json-test/k%d", i)
+			if _, err := vc.Logical().Write(path, data); err != nil {
+				t.Fatalf("Unable to write test secret: %q", err)
+			}
+		}
+
+		ui := new(cli.MockUi)
+		c := &ListCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"-format=json", "secret/list-json-test"}
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		var keys []string
+		if err := json.Unmarshal([]byte(ui.OutputWriter.String()), &keys); err != nil {
+			t.Fatalf("expected output to be a JSON array of keys, got: %s\nerror: %q", ui.OutputWriter.String(), err)
+		}
+
+		if len(keys) != 11 {
+			t.Fatalf("expected 11 keys, got %d: %v", len(keys), keys)
+		}
+	})
+
+	t.Run("TableOrderMatchesVault", func(t *testing.T) {
+
+		keys, err := listSecrets("secret/list-json-test")
+		if err != nil {
+			t.Fatalf("Unable to list test secrets: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &ListCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"secret/list-json-test"}
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := strings.Join(keys, "\n")
+		actual := strings.TrimRight(ui.OutputWriter.String(), "\n")
+		if actual != expected {
+			t.Fatalf("expected table output to preserve Vault's key order:\n%s\n\ngot:\n%s", expected, actual)
+		}
+	})
+}
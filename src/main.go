@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mitchellh/cli"
+)
+
+func main() {
+	if err := LoadConfig(); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	if err := InitializeClient(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	ui := &cli.BasicUi{
+		Writer:      os.Stdout,
+		ErrorWriter: os.Stderr,
+		Reader:      os.Stdin,
+	}
+
+	c := cli.NewCLI("vault-client", "0.1.0")
+	c.Args = os.Args[1:]
+	c.Commands = map[string]cli.CommandFactory{
+		"read": func() (cli.Command, error) {
+			return &ReadCommand{BaseCommand{Ui: ui}}, nil
+		},
+		"write": func() (cli.Command, error) {
+			return &WriteCommand{BaseCommand{Ui: ui}}, nil
+		},
+		"list": func() (cli.Command, error) {
+			return &ListCommand{BaseCommand{Ui: ui}}, nil
+		},
+		"rm": func() (cli.Command, error) {
+			return &DeleteCommand{Ui: ui}, nil
+		},
+		"patch": func() (cli.Command, error) {
+			return &PatchCommand{BaseCommand{Ui: ui}}, nil
+		},
+		"undelete": func() (cli.Command, error) {
+			return &UndeleteCommand{Ui: ui}, nil
+		},
+		"destroy": func() (cli.Command, error) {
+			return &DestroyCommand{Ui: ui}, nil
+		},
+		"mv": func() (cli.Command, error) {
+			return &SecretsMoveCommand{Ui: ui}, nil
+		},
+	}
+
+	exitStatus, err := c.Run()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	os.Exit(exitStatus)
+}
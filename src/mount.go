@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// kvMount describes the KV engine mounted at a given path.
+type kvMount struct {
+	path string
+	v2   bool
+}
+
+// kvMountFor looks up the mount that a secret path belongs to and reports
+// whether it is a KV version 2 (versioned) engine.
+func kvMountFor(path string) (*kvMount, error) {
+	secret, err := vc.Logical().Read("sys/internal/ui/mounts/" + path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine mount for %q: %s", path, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("no mount found for %q", path)
+	}
+
+	mountPath, _ := secret.Data["path"].(string)
+
+	v2 := false
+	if options, ok := secret.Data["options"].(map[string]interface{}); ok {
+		if version, ok := options["version"].(string); ok && version == "2" {
+			v2 = true
+		}
+	}
+
+	return &kvMount{path: strings.TrimSuffix(mountPath, "/"), v2: v2}, nil
+}
+
+// dataPath rewrites a KV v1 path to the equivalent KV v2 path for the
+// given sub-resource ("data", "delete", "undelete", "destroy" or
+// "metadata").
+func (m *kvMount) dataPath(resource, path string) string {
+	rest := strings.TrimPrefix(path, m.path+"/")
+	return fmt.Sprintf("%s/%s/%s", m.path, resource, rest)
+}
+
+// parseVersions parses a comma separated list of version numbers, e.g.
+// "1,2,3", into a slice of ints for use in KV v2 requests.
+func parseVersions(raw string) ([]int, error) {
+	parts := strings.Split(raw, ",")
+	versions := make([]int, 0, len(parts))
+
+	for _, part := range parts {
+		v, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q", part)
+		}
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// SecretsMoveCommand moves (or copies, with -keep-source) a secret, or
+// recursively a whole prefix of secrets, from one path to another.
+type SecretsMoveCommand struct {
+	Ui cli.Ui
+}
+
+func (c *SecretsMoveCommand) Run(args []string) int {
+	flags := flag.NewFlagSet("mv", flag.ContinueOnError)
+	recursive := flags.Bool("recursive", false, "move every secret under the source prefix")
+	keepSource := flags.Bool("keep-source", false, "copy instead of move, leaving the source in place")
+	force := flags.Bool("force", false, "overwrite the destination if it already exists")
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) != 2 {
+		c.Ui.Error("The mv command expects exactly two arguments: source and destination")
+		return 1
+	}
+
+	src := strings.TrimSuffix(args[0], "/")
+	dst := strings.TrimSuffix(args[1], "/")
+
+	var moved, deleted []string
+	var err error
+
+	if *recursive {
+		moved, deleted, err = c.moveRecursive(src, dst, *keepSource, *force)
+	} else {
+		moved, deleted, err = c.moveOne(src, dst, *keepSource, *force)
+	}
+
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	for _, path := range moved {
+		c.Ui.Output(fmt.Sprintf("copied %s", path))
+	}
+	for _, path := range deleted {
+		c.Ui.Output(fmt.Sprintf("deleted %s", path))
+	}
+
+	return 0
+}
+
+func (c *SecretsMoveCommand) moveOne(src, dst string, keepSource, force bool) ([]string, []string, error) {
+	if !force {
+		existing, err := readSecret(dst)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error checking destination: %s", err)
+		}
+		if existing != nil {
+			return nil, nil, fmt.Errorf("destination %q already exists, use -force to overwrite", dst)
+		}
+	}
+
+	data, err := readSecret(src)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading source: %s", err)
+	}
+	if data == nil {
+		return nil, nil, fmt.Errorf("source %q does not exist", src)
+	}
+
+	if _, err := writeSecret(dst, data); err != nil {
+		return nil, nil, fmt.Errorf("error writing destination: %s", err)
+	}
+
+	moved := []string{src}
+	var deleted []string
+
+	if !keepSource {
+		if err := deleteSecret(src); err != nil {
+			return nil, nil, fmt.Errorf("error deleting source: %s", err)
+		}
+		deleted = append(deleted, src)
+	}
+
+	return moved, deleted, nil
+}
+
+func (c *SecretsMoveCommand) moveRecursive(src, dst string, keepSource, force bool) ([]string, []string, error) {
+	keys, err := listSecrets(src)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing %q: %s", src, err)
+	}
+
+	var moved, deleted []string
+
+	for _, key := range keys {
+		childSrc := src + "/" + strings.TrimSuffix(key, "/")
+		childDst := dst + "/" + strings.TrimSuffix(key, "/")
+
+		var m, d []string
+		if strings.HasSuffix(key, "/") {
+			m, d, err = c.moveRecursive(childSrc, childDst, keepSource, force)
+		} else {
+			m, d, err = c.moveOne(childSrc, childDst, keepSource, force)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		moved = append(moved, m...)
+		deleted = append(deleted, d...)
+	}
+
+	return moved, deleted, nil
+}
+
+func (c *SecretsMoveCommand) Help() string {
+	helpText := `
+Usage: vault-client mv [options] [source] [destination]
+
+  Moves the secret at source to destination. With -recursive, moves every
+  secret found under the source prefix, preserving the relative
+  structure.
+
+Options:
+
+  -recursive     Move every secret under the source prefix
+  -keep-source   Copy instead of move, leaving the source in place
+  -force         Overwrite the destination if it already exists
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *SecretsMoveCommand) Synopsis() string {
+	return "Move or copy secrets between paths"
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/mitchellh/cli"
+)
+
+func TestSecretsMove(t *testing.T) {
+
+	err := LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	err = InitializeClient(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	t.Run("Move", func(t *testing.T) {
+
+		data := map[string]interface{}{"key": "value"}
+		if _, err := vc.Logical().Write("secret/mv-src", data); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &SecretsMoveCommand{Ui: ui}
+
+		args := []string{"secret/mv-src", "secret/mv-dst"}
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		if secret, _ := vc.Logical().Read("secret/mv-src"); secret != nil {
+			t.Fatalf("expected source to be deleted")
+		}
+
+		secret, err := vc.Logical().Read("secret/mv-dst")
+		if err != nil || secret == nil {
+			t.Fatalf("expected destination to exist: %v", err)
+		}
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+
+		data := map[string]interface{}{"key": "value"}
+		if _, err := vc.Logical().Write("secret/mv-prefix/a", data); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+		if _, err := vc.Logical().Write("secret/mv-prefix/b", data); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &SecretsMoveCommand{Ui: ui}
+
+		args := []string{"-recursive", "secret/mv-prefix", "secret/mv-prefix-copy"}
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		for _, path := range []string{"secret/mv-prefix-copy/a", "secret/mv-prefix-copy/b"} {
+			secret, err := vc.Logical().Read(path)
+			if err != nil || secret == nil {
+				t.Fatalf("expected %s to exist: %v", path, err)
+			}
+		}
+	})
+
+	t.Run("DestinationExists", func(t *testing.T) {
+
+		data := map[string]interface{}{"key": "value"}
+		if _, err := vc.Logical().Write("secret/mv-exists-src", data); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+		if _, err := vc.Logical().Write("secret/mv-exists-dst", data); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &SecretsMoveCommand{Ui: ui}
+
+		args := []string{"secret/mv-exists-src", "secret/mv-exists-dst"}
+		if rc := c.Run(args); rc != 1 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := "already exists"
+		if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+
+	t.Run("CrossMount", func(t *testing.T) {
+
+		if err := vc.Sys().Mount("kv-v2-mv", &api.MountInput{
+			Type:    "kv",
+			Options: map[string]string{"version": "2"},
+		}); err != nil {
+			t.Fatalf("Unable to mount kv-v2 engine: %q", err)
+		}
+
+		data := map[string]interface{}{"key": "value"}
+		if _, err := vc.Logical().Write("secret/mv-cross", data); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &SecretsMoveCommand{Ui: ui}
+
+		args := []string{"secret/mv-cross", "kv-v2-mv/mv-cross"}
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		secret, err := vc.Logical().Read("kv-v2-mv/data/mv-cross")
+		if err != nil || secret == nil {
+			t.Fatalf("expected destination to exist in kv-v2-mv: %v", err)
+		}
+	})
+}
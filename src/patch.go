@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PatchCommand merges the given key=value pairs into an existing secret
+// instead of overwriting it wholesale like WriteCommand does.
+type PatchCommand struct {
+	BaseCommand
+}
+
+func (c *PatchCommand) Run(args []string) int {
+	flags := c.FlagSet("patch")
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) < 2 {
+		c.Ui.Error("The patch command expects a path and at least one key=value pair")
+		return 1
+	}
+
+	path := args[0]
+
+	data, err := readSecret(path)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading secret: %s", err))
+		return 1
+	}
+	if data == nil {
+		c.Ui.Error("Secret does not exist")
+		return 1
+	}
+
+	updates, deletions, err := parsePatchPairs(args[1:])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing key=value pairs: %s", err))
+		return 1
+	}
+
+	for _, key := range deletions {
+		delete(data, key)
+	}
+	for key, value := range updates {
+		data[key] = value
+	}
+
+	if _, err := writeSecret(path, data); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing secret: %s", err))
+		return 1
+	}
+
+	return c.outputSecret(data)
+}
+
+func (c *PatchCommand) Help() string {
+	helpText := `
+Usage: vault-client patch [options] [path] [key=value]...
+
+  Merges the given key=value pairs into the secret at path, leaving any
+  other fields untouched. A field is removed by assigning it an empty
+  value, e.g. key=.
+
+  Values may be read from a file with key=@file or from stdin with
+  key=-.
+
+Options:
+
+  -field=name       Print only this field of the merged secret
+  -format=table      Output format: json, yaml or table (default: table)
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *PatchCommand) Synopsis() string {
+	return "Merge fields into an existing secret"
+}
+
+// parsePatchPairs splits "key=value" style arguments into fields to set
+// and fields to delete. A literal "key=" with nothing after the "="
+// marks the field for deletion; this is distinct from a value that
+// merely resolves to an empty string via @file or - (stdin), which is
+// set like any other value.
+func parsePatchPairs(pairs []string) (map[string]interface{}, []string, error) {
+	updates := make(map[string]interface{})
+	var deletions []string
+
+	for _, pair := range pairs {
+		key, raw, err := splitKeyValue(pair)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if raw == "" {
+			deletions = append(deletions, key)
+			continue
+		}
+
+		value, err := resolveValue(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to resolve value for %q: %s", key, err)
+		}
+
+		updates[key] = value
+	}
+
+	return updates, deletions, nil
+}
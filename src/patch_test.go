@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestPatch(t *testing.T) {
+
+	err := LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	err = InitializeClient(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	ui := new(cli.MockUi)
+	c := &PatchCommand{BaseCommand{Ui: ui, flagFormat: "table"}}
+
+	t.Run("TooFewArgs", func(t *testing.T) {
+
+		args := []string{"secret/doesntexist"}
+
+		if rc := c.Run(args); rc != 1 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := "The patch command expects a path and at least one key=value pair"
+		if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+
+	t.Run("DeleteFieldVsEmptyResolvedValue", func(t *testing.T) {
+
+		data := make(map[string]interface{})
+		data["key"] = "value"
+		data["removeme"] = "value"
+
+		_, err = vc.Logical().Write("secret/existent", data)
+		if err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		emptyFile, err := ioutil.TempFile("", "patch-empty")
+		if err != nil {
+			t.Fatalf("Unable to create empty temp file: %q", err)
+		}
+		defer os.Remove(emptyFile.Name())
+		emptyFile.Close()
+
+		ui := new(cli.MockUi)
+		c := &PatchCommand{BaseCommand{Ui: ui, flagFormat: "table"}}
+
+		args := []string{
+			"secret/existent",
+			"removeme=",
+			fmt.Sprintf("key=@%s", emptyFile.Name()),
+		}
+
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		secret, err := vc.Logical().Read("secret/existent")
+		if err != nil {
+			t.Fatalf("Unable to read test secret: %q", err)
+		}
+
+		if _, ok := secret.Data["removeme"]; ok {
+			t.Fatalf("expected removeme to be deleted, got: %v", secret.Data["removeme"])
+		}
+
+		if value, ok := secret.Data["key"]; !ok || value != "" {
+			t.Fatalf("expected key to be set to an empty string, got: %v (present: %v)", value, ok)
+		}
+	})
+
+	t.Run("NonexistentSecret", func(t *testing.T) {
+
+		args := []string{"secret/doesntexist", "key=value"}
+
+		if rc := c.Run(args); rc != 1 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := "Secret does not exist"
+		if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+
+	t.Run("ExistentSecret", func(t *testing.T) {
+
+		data := make(map[string]interface{})
+		data["key"] = "value"
+		data["other"] = "untouched"
+
+		_, err = vc.Logical().Write("secret/existent", data)
+		if err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &PatchCommand{BaseCommand{Ui: ui, flagFormat: "table"}}
+
+		args := []string{"secret/existent", "key=updated"}
+
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		secret, err := vc.Logical().Read("secret/existent")
+		if err != nil {
+			t.Fatalf("Unable to read test secret: %q", err)
+		}
+
+		if secret.Data["key"] != "updated" {
+			t.Fatalf("expected key to be updated, got: %v", secret.Data["key"])
+		}
+		if secret.Data["other"] != "untouched" {
+			t.Fatalf("expected other to be untouched, got: %v", secret.Data["other"])
+		}
+	})
+
+	t.Run("DeleteField", func(t *testing.T) {
+
+		data := make(map[string]interface{})
+		data["key"] = "value"
+		data["removeme"] = "value"
+
+		_, err = vc.Logical().Write("secret/existent", data)
+		if err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &PatchCommand{BaseCommand{Ui: ui, flagFormat: "table"}}
+
+		args := []string{"secret/existent", "removeme="}
+
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		secret, err := vc.Logical().Read("secret/existent")
+		if err != nil {
+			t.Fatalf("Unable to read test secret: %q", err)
+		}
+
+		if _, ok := secret.Data["removeme"]; ok {
+			t.Fatalf("expected removeme to be deleted, got: %v", secret.Data["removeme"])
+		}
+	})
+}
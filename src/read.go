@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadCommand prints the secret stored at a given path.
+type ReadCommand struct {
+	BaseCommand
+}
+
+func (c *ReadCommand) Run(args []string) int {
+	flags := c.FlagSet("read")
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) != 1 {
+		c.Ui.Error("The read command expects exactly one argument")
+		return 1
+	}
+
+	path := args[0]
+
+	data, err := readSecret(path)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reading secret: %s", err))
+		return 1
+	}
+	if data == nil {
+		c.Ui.Error("Secret does not exist")
+		return 1
+	}
+
+	return c.outputSecret(data)
+}
+
+func (c *ReadCommand) Help() string {
+	helpText := `
+Usage: vault-client read [options] [path]
+
+  Prints the secret stored at the given path.
+
+Options:
+
+  -field=name       Print only this field of the secret
+  -format=table      Output format: json, yaml or table (default: table)
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ReadCommand) Synopsis() string {
+	return "Read a secret"
+}
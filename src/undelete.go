@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// UndeleteCommand restores one or more soft-deleted versions of a KV v2
+// secret.
+type UndeleteCommand struct {
+	Ui cli.Ui
+}
+
+func (c *UndeleteCommand) Run(args []string) int {
+	flags := flag.NewFlagSet("undelete", flag.ContinueOnError)
+	versionsRaw := flags.String("versions", "", "comma separated list of versions to undelete")
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) != 1 {
+		c.Ui.Error("The undelete command expects exactly one argument")
+		return 1
+	}
+	if *versionsRaw == "" {
+		c.Ui.Error("The undelete command requires -versions")
+		return 1
+	}
+
+	path := args[0]
+
+	mount, err := kvMountFor(path)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	if !mount.v2 {
+		c.Ui.Error("undelete is only supported for KV v2 mounts")
+		return 1
+	}
+
+	versions, err := parseVersions(*versionsRaw)
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	_, err = vc.Logical().Write(mount.dataPath("undelete", path), map[string]interface{}{"versions": versions})
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error undeleting secret versions: %s", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (c *UndeleteCommand) Help() string {
+	helpText := `
+Usage: vault-client undelete -versions=1,2,3 [path]
+
+  Restores the given soft-deleted versions of a KV v2 secret.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *UndeleteCommand) Synopsis() string {
+	return "Restore soft-deleted secret versions"
+}
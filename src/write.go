@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// WriteCommand writes (overwrites) a secret at a given path with the
+// supplied key=value pairs.
+type WriteCommand struct {
+	BaseCommand
+}
+
+func (c *WriteCommand) Run(args []string) int {
+	flags := c.FlagSet("write")
+	if err := flags.Parse(args); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	args = flags.Args()
+
+	if len(args) < 2 {
+		c.Ui.Error("The write command expects a path and at least one key=value pair")
+		return 1
+	}
+
+	path := args[0]
+
+	data, err := parseKeyValues(args[1:])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error parsing key=value pairs: %s", err))
+		return 1
+	}
+
+	response, err := writeSecret(path, data)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing secret: %s", err))
+		return 1
+	}
+	if response == nil {
+		response = map[string]interface{}{}
+	}
+
+	return c.outputSecret(response)
+}
+
+func (c *WriteCommand) Help() string {
+	helpText := `
+Usage: vault-client write [options] [path] [key=value]...
+
+  Writes the given key=value pairs to the secret at path, overwriting
+  any existing data.
+
+  Values may be read from a file with key=@file or from stdin with
+  key=-.
+
+Options:
+
+  -field=name       Print only this field of the response, if any
+  -format=table      Output format: json, yaml or table (default: table)
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *WriteCommand) Synopsis() string {
+	return "Write a secret"
+}
+
+// parseKeyValues turns a list of "key=value" style arguments into a data
+// map suitable for vc.Logical().Write. A value of "@file" is read from
+// file, and a value of "-" is read from stdin.
+func parseKeyValues(pairs []string) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+
+	for _, pair := range pairs {
+		key, raw, err := splitKeyValue(pair)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := resolveValue(raw)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve value for %q: %s", key, err)
+		}
+
+		data[key] = value
+	}
+
+	return data, nil
+}
+
+// splitKeyValue splits a "key=value" argument into its key and raw
+// (unresolved) value.
+func splitKeyValue(pair string) (string, string, error) {
+	parts := strings.SplitN(pair, "=", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid key=value pair: %q", pair)
+	}
+	return parts[0], parts[1], nil
+}
+
+// resolveValue resolves the raw right-hand side of a key=value argument:
+// "-" reads from stdin, "@file" reads from file, anything else is used
+// literally.
+func resolveValue(raw string) (string, error) {
+	switch {
+	case raw == "-":
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("unable to read from stdin: %s", err)
+		}
+		return strings.TrimRight(string(b), "\n"), nil
+	case strings.HasPrefix(raw, "@"):
+		b, err := ioutil.ReadFile(raw[1:])
+		if err != nil {
+			return "", fmt.Errorf("unable to read file %q: %s", raw[1:], err)
+		}
+		return strings.TrimRight(string(b), "\n"), nil
+	default:
+		return raw, nil
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestWrite(t *testing.T) {
+
+	err := LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	err = InitializeClient(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	ui := new(cli.MockUi)
+	c := &WriteCommand{BaseCommand{Ui: ui}}
+
+	t.Run("TooFewArgs", func(t *testing.T) {
+
+		args := []string{"secret/write-test"}
+
+		if rc := c.Run(args); rc != 1 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := "The write command expects a path and at least one key=value pair"
+		if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+
+	t.Run("NewSecret", func(t *testing.T) {
+
+		ui := new(cli.MockUi)
+		c := &WriteCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"secret/write-test", "key=value"}
+
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		secret, err := vc.Logical().Read("secret/write-test")
+		if err != nil {
+			t.Fatalf("Unable to read test secret: %q", err)
+		}
+
+		if secret.Data["key"] != "value" {
+			t.Fatalf("expected key to be set, got: %v", secret.Data["key"])
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+
+		if _, err := vc.Logical().Write("secret/write-test", map[string]interface{}{"key": "value", "other": "stays"}); err != nil {
+			t.Fatalf("Unable to write test secret: %q", err)
+		}
+
+		ui := new(cli.MockUi)
+		c := &WriteCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"secret/write-test", "key=updated"}
+
+		if rc := c.Run(args); rc != 0 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		secret, err := vc.Logical().Read("secret/write-test")
+		if err != nil {
+			t.Fatalf("Unable to read test secret: %q", err)
+		}
+
+		if secret.Data["key"] != "updated" {
+			t.Fatalf("expected key to be updated, got: %v", secret.Data["key"])
+		}
+		if _, ok := secret.Data["other"]; ok {
+			t.Fatalf("expected write to overwrite the whole secret, got leftover field: %v", secret.Data["other"])
+		}
+	})
+
+	t.Run("NoResponseDataStillHonoursFieldFlag", func(t *testing.T) {
+
+		ui := new(cli.MockUi)
+		c := &WriteCommand{BaseCommand{Ui: ui}}
+
+		args := []string{"-field=password", "secret/write-test", "key=value"}
+
+		if rc := c.Run(args); rc != 1 {
+			t.Fatalf("Wrong exit code. errors: \n%s", ui.ErrorWriter.String())
+		}
+
+		expected := `field "password" not present in secret`
+		if actual := ui.ErrorWriter.String(); !strings.Contains(actual, expected) {
+			t.Fatalf("expected:\n%s\n\nto include: %q", actual, expected)
+		}
+	})
+}